@@ -0,0 +1,54 @@
+package uuid
+
+import "errors"
+
+// MarshalText implements encoding.TextMarshaler.
+func (uuid UUID) MarshalText() ([]byte, error) {
+	return uuid.AppendFormatted(make([]byte, 0, 36)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (uuid *UUID) UnmarshalText(data []byte) error {
+	parsed, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*uuid = parsed
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (uuid UUID) MarshalBinary() ([]byte, error) {
+	return uuid[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (uuid *UUID) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return errors.New("uuid: invalid binary length, expected 16 bytes")
+	}
+	copy(uuid[:], data)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (uuid UUID) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, 38)
+	buf = append(buf, '"')
+	buf = uuid.AppendFormatted(buf)
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null decodes to the
+// zero-value UUID.
+func (uuid *UUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*uuid = Nil
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("uuid: invalid JSON UUID, expected a quoted string")
+	}
+	return uuid.UnmarshalText(data[1 : len(data)-1])
+}
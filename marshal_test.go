@@ -0,0 +1,94 @@
+package uuid
+
+import "testing"
+
+func TestTextMarshalRoundTrip(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+
+	data, err := want.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "15588635-a45e-4867-aadb-dbf0385ade95" {
+		t.Errorf("MarshalText() = %q, want canonical string", data)
+	}
+
+	var got UUID
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("UnmarshalText(MarshalText()) = %v, want %v", got, want)
+	}
+}
+
+func TestTextUnmarshalInvalid(t *testing.T) {
+	var u UUID
+	if err := u.UnmarshalText([]byte("not-a-uuid")); err == nil {
+		t.Error("UnmarshalText(invalid) expected error, got nil")
+	}
+}
+
+func TestBinaryMarshalRoundTrip(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 16 {
+		t.Fatalf("MarshalBinary() returned %d bytes, want 16", len(data))
+	}
+
+	var got UUID
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("UnmarshalBinary(MarshalBinary()) = %v, want %v", got, want)
+	}
+}
+
+func TestBinaryUnmarshalInvalidLength(t *testing.T) {
+	var u UUID
+	if err := u.UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Error("UnmarshalBinary(3 bytes) expected error, got nil")
+	}
+}
+
+func TestJSONMarshalRoundTrip(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+
+	data, err := want.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"15588635-a45e-4867-aadb-dbf0385ade95"` {
+		t.Errorf("MarshalJSON() = %s, want quoted canonical string", data)
+	}
+
+	var got UUID
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("UnmarshalJSON(MarshalJSON()) = %v, want %v", got, want)
+	}
+}
+
+func TestJSONUnmarshalNull(t *testing.T) {
+	u := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+	if err := u.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if u != Nil {
+		t.Errorf("UnmarshalJSON(null) = %v, want Nil", u)
+	}
+}
+
+func TestJSONUnmarshalInvalid(t *testing.T) {
+	var u UUID
+	if err := u.UnmarshalJSON([]byte("15588635-a45e-4867-aadb-dbf0385ade95")); err == nil {
+		t.Error("UnmarshalJSON(unquoted) expected error, got nil")
+	}
+}
@@ -0,0 +1,52 @@
+package uuid
+
+import "sync"
+
+// Nil is the zero-value UUID, with all 128 bits set to zero.
+var Nil UUID
+
+// Predefined namespace UUIDs, as specified in RFC 4122 Appendix C. These are
+// the namespaces used to generate type 3 (MD5) and type 5 (SHA-1) UUIDs
+// from names in each of the given domains.
+var (
+	NamespaceDNS  = MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceURL  = MustParse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceOID  = MustParse("6ba7b812-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceX500 = MustParse("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+)
+
+var sha1GenPool = sync.Pool{
+	New: func() interface{} {
+		return NewSHA1Gen(Nil)
+	},
+}
+
+var md5GenPool = sync.Pool{
+	New: func() interface{} {
+		return NewMD5Gen(Nil)
+	},
+}
+
+// NewSHA1 generates a new type 5 (SHA-1) UUID in the given namespace for
+// the given data, without requiring the caller to manage a Generator's
+// lifetime themselves.
+func NewSHA1(ns UUID, data []byte) UUID {
+	gen := sha1GenPool.Get().(*Generator)
+	gen.namespace = ns[:]
+	var u UUID
+	gen.Generate(&u, data)
+	sha1GenPool.Put(gen)
+	return u
+}
+
+// NewMD5 generates a new type 3 (MD5) UUID in the given namespace for the
+// given data, without requiring the caller to manage a Generator's
+// lifetime themselves.
+func NewMD5(ns UUID, data []byte) UUID {
+	gen := md5GenPool.Get().(*Generator)
+	gen.namespace = ns[:]
+	var u UUID
+	gen.Generate(&u, data)
+	md5GenPool.Put(gen)
+	return u
+}
@@ -0,0 +1,71 @@
+package uuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestNamespaceValues(t *testing.T) {
+	cases := []struct {
+		name string
+		got  UUID
+		want string
+	}{
+		{"NamespaceDNS", NamespaceDNS, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"NamespaceURL", NamespaceURL, "6ba7b811-9dad-11d1-80b4-00c04fd430c8"},
+		{"NamespaceOID", NamespaceOID, "6ba7b812-9dad-11d1-80b4-00c04fd430c8"},
+		{"NamespaceX500", NamespaceX500, "6ba7b814-9dad-11d1-80b4-00c04fd430c8"},
+	}
+	for _, c := range cases {
+		if got := c.got.String(); got != c.want {
+			t.Errorf("%s = %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestNewSHA1MatchesGenerator(t *testing.T) {
+	data := []byte("example.com")
+
+	var want UUID
+	NewSHA1Gen(NamespaceDNS).Generate(&want, data)
+
+	if got := NewSHA1(NamespaceDNS, data); got != want {
+		t.Errorf("NewSHA1(NamespaceDNS, %q) = %v, want %v", data, got, want)
+	}
+}
+
+func TestNewMD5MatchesGenerator(t *testing.T) {
+	data := []byte("example.com")
+
+	var want UUID
+	NewMD5Gen(NamespaceDNS).Generate(&want, data)
+
+	if got := NewMD5(NamespaceDNS, data); got != want {
+		t.Errorf("NewMD5(NamespaceDNS, %q) = %v, want %v", data, got, want)
+	}
+}
+
+// TestNewSHA1Concurrent exercises the sync.Pool of Generators under
+// concurrent use, to catch bugs where a pooled Generator's namespace leaks
+// across callers.
+func TestNewSHA1Concurrent(t *testing.T) {
+	namespaces := []UUID{NamespaceDNS, NamespaceURL, NamespaceOID, NamespaceX500}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		ns := namespaces[i%len(namespaces)]
+		wg.Add(1)
+		go func(ns UUID) {
+			defer wg.Done()
+			data := []byte("example.com")
+
+			var want UUID
+			NewSHA1Gen(ns).Generate(&want, data)
+
+			if got := NewSHA1(ns, data); got != want {
+				t.Errorf("NewSHA1(%v, %q) = %v, want %v", ns, data, got, want)
+			}
+		}(ns)
+	}
+	wg.Wait()
+}
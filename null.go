@@ -0,0 +1,59 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// NullUUID represents a UUID that may be null, mirroring sql.NullString.
+// It implements driver.Valuer, sql.Scanner and the JSON marshaling
+// interfaces so that columns declared NULL-able round-trip cleanly. Unlike
+// UUID, it does not implement the text or binary marshaling interfaces.
+type NullUUID struct {
+	UUID  UUID
+	Valid bool // Valid is true if UUID is not NULL
+}
+
+// Value implements driver.Valuer.
+func (n NullUUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.UUID.Value()
+}
+
+// Scan implements sql.Scanner.
+func (n *NullUUID) Scan(src interface{}) error {
+	if src == nil {
+		n.UUID, n.Valid = Nil, false
+		return nil
+	}
+	if err := n.UUID.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding an invalid NullUUID as
+// JSON null.
+func (n NullUUID) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.UUID.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *NullUUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.UUID, n.Valid = Nil, false
+		return nil
+	}
+	if err := json.Unmarshal(data, &n.UUID); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
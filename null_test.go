@@ -0,0 +1,98 @@
+package uuid
+
+import "testing"
+
+func TestNullUUIDValueValid(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+	n := NullUUID{UUID: want, Valid: true}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "15588635-a45e-4867-aadb-dbf0385ade95" {
+		t.Errorf("Value() = %v, want canonical string", v)
+	}
+}
+
+func TestNullUUIDValueInvalid(t *testing.T) {
+	n := NullUUID{UUID: MustParse("15588635-a45e-4867-aadb-dbf0385ade95")}
+
+	v, err := n.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil {
+		t.Errorf("Value() = %v, want nil", v)
+	}
+}
+
+func TestNullUUIDScan(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+
+	var n NullUUID
+	if err := n.Scan("15588635-a45e-4867-aadb-dbf0385ade95"); err != nil {
+		t.Fatal(err)
+	}
+	if !n.Valid || n.UUID != want {
+		t.Errorf("Scan(string) = %+v, want {%v true}", n, want)
+	}
+
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid || n.UUID != Nil {
+		t.Errorf("Scan(nil) = %+v, want {Nil false}", n)
+	}
+}
+
+func TestNullUUIDScanInvalid(t *testing.T) {
+	n := NullUUID{UUID: MustParse("15588635-a45e-4867-aadb-dbf0385ade95"), Valid: true}
+	if err := n.Scan(42); err == nil {
+		t.Error("Scan(42) expected error, got nil")
+	}
+	if n.Valid {
+		t.Error("Scan(42) left Valid true after failure, want false")
+	}
+}
+
+func TestNullUUIDJSON(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+	valid := NullUUID{UUID: want, Valid: true}
+
+	data, err := valid.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"15588635-a45e-4867-aadb-dbf0385ade95"` {
+		t.Errorf("MarshalJSON() = %s, want quoted canonical string", data)
+	}
+
+	var got NullUUID
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Valid || got.UUID != want {
+		t.Errorf("UnmarshalJSON(%s) = %+v, want {%v true}", data, got, want)
+	}
+}
+
+func TestNullUUIDJSONNull(t *testing.T) {
+	invalid := NullUUID{}
+
+	data, err := invalid.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON() = %s, want null", data)
+	}
+
+	got := NullUUID{UUID: MustParse("15588635-a45e-4867-aadb-dbf0385ade95"), Valid: true}
+	if err := got.UnmarshalJSON([]byte("null")); err != nil {
+		t.Fatal(err)
+	}
+	if got.Valid || got.UUID != Nil {
+		t.Errorf("UnmarshalJSON(null) = %+v, want {Nil false}", got)
+	}
+}
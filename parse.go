@@ -0,0 +1,153 @@
+package uuid
+
+import "fmt"
+
+// xvalues maps an ASCII byte to its hex nibble value, or 0xff if the byte
+// is not a valid hex digit.
+var xvalues = func() [256]byte {
+	var t [256]byte
+	for i := range t {
+		t[i] = 0xff
+	}
+	for c := byte('0'); c <= '9'; c++ {
+		t[c] = c - '0'
+	}
+	for c := byte('a'); c <= 'f'; c++ {
+		t[c] = c - 'a' + 10
+	}
+	for c := byte('A'); c <= 'F'; c++ {
+		t[c] = c - 'A' + 10
+	}
+	return t
+}()
+
+// Parse parses a string to a UUID object. The fast path accepts the
+// standard 36 char canonical format with '-' separators in the
+// appropriate places; anything else falls back to the more lenient
+// ParseBytes, which also accepts hyphen-less, braced and URN forms.
+func Parse(s string) (UUID, error) {
+	var uuid UUID
+	if len(s) == 36 && s[8] == '-' && s[13] == '-' && s[18] == '-' && s[23] == '-' {
+		if err := decodeCanonicalString(&uuid, s); err != nil {
+			return uuid, err
+		}
+		return uuid, nil
+	}
+	return ParseBytes([]byte(s))
+}
+
+// MustParse parses a string to a UUID object, and will panic if it fails.
+func MustParse(s string) UUID {
+	uuid, err := Parse(s)
+	if err != nil {
+		panic(`uuid: Parse(` + s + `): ` + err.Error())
+	}
+	return uuid
+}
+
+// ParseBytes parses a UUID from a byte slice, without requiring the caller
+// to convert from a network buffer to a string first. In addition to the
+// canonical 36-char form, it accepts:
+//
+//   - the 32-char hyphen-less form
+//   - either of the above wrapped in Microsoft-style braces, e.g. "{...}"
+//   - the URN form, e.g. "urn:uuid:..."
+//   - uppercase or mixed-case hex digits
+func ParseBytes(b []byte) (UUID, error) {
+	var uuid UUID
+	b = trimBracesAndURN(b)
+	switch len(b) {
+	case 36:
+		if b[8] != '-' || b[13] != '-' || b[18] != '-' || b[23] != '-' {
+			return uuid, fmt.Errorf("uuid: invalid format, expected '-' at positions 8, 13, 18 and 23")
+		}
+		if err := decodeHex(uuid[:], b, true); err != nil {
+			return uuid, err
+		}
+	case 32:
+		if err := decodeHex(uuid[:], b, false); err != nil {
+			return uuid, err
+		}
+	default:
+		return uuid, fmt.Errorf("uuid: invalid UUID length %d", len(b))
+	}
+	return uuid, nil
+}
+
+// trimBracesAndURN strips an optional pair of surrounding braces and an
+// optional case-insensitive "urn:uuid:" prefix.
+func trimBracesAndURN(b []byte) []byte {
+	if len(b) >= 2 && b[0] == '{' && b[len(b)-1] == '}' {
+		b = b[1 : len(b)-1]
+	}
+	const urnPrefix = "urn:uuid:"
+	if len(b) >= len(urnPrefix) {
+		isURN := true
+		for i := 0; i < len(urnPrefix); i++ {
+			c := b[i]
+			if c >= 'A' && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			if c != urnPrefix[i] {
+				isURN = false
+				break
+			}
+		}
+		if isURN {
+			b = b[len(urnPrefix):]
+		}
+	}
+	return b
+}
+
+// decodeHex decodes the hex digits in src into dst, skipping the four
+// hyphens at the canonical positions when hyphenated is true. It performs
+// no allocations.
+func decodeHex(dst []byte, src []byte, hyphenated bool) error {
+	di := 0
+	high := true
+	for i, c := range src {
+		if hyphenated && (i == 8 || i == 13 || i == 18 || i == 23) {
+			continue
+		}
+		v := xvalues[c]
+		if v == 0xff {
+			return fmt.Errorf("uuid: invalid character %q at position %d", c, i)
+		}
+		if high {
+			dst[di] = v << 4
+			high = false
+		} else {
+			dst[di] |= v
+			di++
+			high = true
+		}
+	}
+	return nil
+}
+
+// decodeCanonicalString is the zero-allocation fast path for the 36-char
+// canonical form, operating on a string directly rather than via a []byte
+// conversion.
+func decodeCanonicalString(dst *UUID, s string) error {
+	di := 0
+	high := true
+	for i := 0; i < len(s); i++ {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			continue
+		}
+		v := xvalues[s[i]]
+		if v == 0xff {
+			return fmt.Errorf("uuid: invalid character %q at position %d", s[i], i)
+		}
+		if high {
+			dst[di] = v << 4
+			high = false
+		} else {
+			dst[di] |= v
+			di++
+			high = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestParseLenient(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+
+	cases := []string{
+		"15588635-a45e-4867-aadb-dbf0385ade95",
+		"15588635A45E4867AADBDBF0385ADE95",
+		"15588635a45e4867aadbdbf0385ade95",
+		"{15588635-a45e-4867-aadb-dbf0385ade95}",
+		"urn:uuid:15588635-a45e-4867-aadb-dbf0385ade95",
+		"URN:UUID:15588635-A45E-4867-AADB-DBF0385ADE95",
+	}
+	for _, c := range cases {
+		got, err := Parse(c)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", c, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Parse(%q) = %v, want %v", c, got, want)
+		}
+	}
+}
+
+func TestParseBytesInvalid(t *testing.T) {
+	cases := []string{
+		"",
+		"not-a-uuid",
+		"15588635-a45e-4867-aadb-dbf0385ade9",
+		"15588635_a45e_4867_aadb_dbf0385ade95",
+		"zz588635-a45e-4867-aadb-dbf0385ade95",
+	}
+	for _, c := range cases {
+		if _, err := ParseBytes([]byte(c)); err == nil {
+			t.Errorf("ParseBytes(%q) expected error, got nil", c)
+		}
+	}
+}
+
+// naiveParse reimplements the original allocating parser, kept only so the
+// byte-wise decoder in parse.go can be benchmarked against it.
+func naiveParse(s string) (UUID, error) {
+	var uuid UUID
+	s = strings.ReplaceAll(s, "-", "")
+	dec, err := hex.DecodeString(s)
+	if err != nil {
+		return uuid, err
+	}
+	copy(uuid[:], dec)
+	return uuid, nil
+}
+
+func BenchmarkParse(b *testing.B) {
+	const s = "15588635-a45e-4867-aadb-dbf0385ade95"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	buf := []byte("15588635-a45e-4867-aadb-dbf0385ade95")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseBytes(buf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseNaive(b *testing.B) {
+	const s = "15588635-a45e-4867-aadb-dbf0385ade95"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := naiveParse(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
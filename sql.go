@@ -0,0 +1,46 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, returning the UUID in its canonical
+// 36-char string form.
+func (uuid UUID) Value() (driver.Value, error) {
+	return uuid.String(), nil
+}
+
+// Scan implements sql.Scanner, accepting a string (canonical or raw 16-byte
+// form), a []byte ([]byte holding either the raw 16 bytes or the 36-char
+// text form), or nil.
+func (uuid *UUID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*uuid = Nil
+		return nil
+	case string:
+		return uuid.scanBytes([]byte(v))
+	case []byte:
+		return uuid.scanBytes(v)
+	default:
+		return fmt.Errorf("uuid: cannot scan type %T into UUID", src)
+	}
+}
+
+func (uuid *UUID) scanBytes(data []byte) error {
+	switch len(data) {
+	case 16:
+		copy(uuid[:], data)
+		return nil
+	case 36:
+		parsed, err := Parse(string(data))
+		if err != nil {
+			return err
+		}
+		*uuid = parsed
+		return nil
+	default:
+		return fmt.Errorf("uuid: cannot scan %d bytes into UUID", len(data))
+	}
+}
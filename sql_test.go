@@ -0,0 +1,63 @@
+package uuid
+
+import "testing"
+
+func TestValue(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+
+	v, err := want.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "15588635-a45e-4867-aadb-dbf0385ade95" {
+		t.Errorf("Value() = %v, want canonical string", v)
+	}
+}
+
+func TestScan(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+
+	cases := []struct {
+		name string
+		src  interface{}
+	}{
+		{"string", "15588635-a45e-4867-aadb-dbf0385ade95"},
+		{"16-byte []byte", want.Bytes()},
+		{"36-byte []byte", []byte("15588635-a45e-4867-aadb-dbf0385ade95")},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got UUID
+			if err := got.Scan(c.src); err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("Scan(%v) = %v, want %v", c.src, got, want)
+			}
+		})
+	}
+}
+
+func TestScanNil(t *testing.T) {
+	got := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+	if err := got.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got != Nil {
+		t.Errorf("Scan(nil) = %v, want Nil", got)
+	}
+}
+
+func TestScanInvalid(t *testing.T) {
+	cases := []interface{}{
+		42,
+		[]byte("too short"),
+		"not-a-uuid-at-all-not-a-uuid-at-all",
+	}
+	for _, c := range cases {
+		var u UUID
+		if err := u.Scan(c); err == nil {
+			t.Errorf("Scan(%v) expected error, got nil", c)
+		}
+	}
+}
@@ -3,43 +3,11 @@ package uuid
 import (
 	"crypto/md5"
 	"crypto/sha1"
-	"encoding/hex"
-	"errors"
-	"fmt"
 	"hash"
-	"strings"
 )
 
 type UUID [16]byte
 
-// Parse parses a string to a UUID object. The input must be the standard 36
-// char format with '-' chars separators in the appropriate places.
-func Parse(s string) (UUID, error) {
-	var uuid UUID
-	if len(s) != 36 {
-		return uuid, fmt.Errorf("Expected length 36, got %d", len(s))
-	}
-	if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
-		return uuid, errors.New("invalid UUID format")
-	}
-	s = strings.ReplaceAll(s, "-", "")
-	dec, err := hex.DecodeString(s)
-	if err != nil {
-		return uuid, err
-	}
-	copy(uuid[:], dec)
-	return uuid, nil
-}
-
-// MustParse parses a string to a UUID object, and will panic if it fails.
-func MustParse(s string) UUID {
-	uuid, err := Parse(s)
-	if err != nil {
-		panic(`uuid: Parse(` + s + `): ` + err.Error())
-	}
-	return uuid
-}
-
 // NewMD5Gen returns a new UUID generator for type 3 uuids using the given
 // namespace.
 func NewMD5Gen(namespace UUID) *Generator {
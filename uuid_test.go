@@ -133,6 +133,15 @@ func BenchmarkString(b *testing.B) {
 	}
 
 }
+func BenchmarkV4(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewV4(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkAppendBytes(b *testing.B) {
 	b.ReportAllocs()
 
@@ -0,0 +1,84 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+)
+
+// v4BatchUUIDs is the number of UUIDs' worth of randomness read from the
+// random source in one go, to amortise the cost of the underlying syscall.
+const v4BatchUUIDs = 16
+
+var v4Gen = newV4Gen()
+
+// NewV4 generates a new version 4 (random) UUID, reading randomness from
+// the package-level generator.
+func NewV4() (UUID, error) {
+	return v4Gen.generate()
+}
+
+// MustNewV4 is like NewV4 but panics if reading randomness fails.
+func MustNewV4() UUID {
+	u, err := NewV4()
+	if err != nil {
+		panic("uuid: " + err.Error())
+	}
+	return u
+}
+
+// SetRand sets the random source used by NewV4 and MustNewV4. Passing nil
+// resets it to crypto/rand.Reader. This is primarily useful for tests and
+// for callers that want to supply their own (e.g. hardware) RNG.
+func SetRand(r io.Reader) {
+	v4Gen.setRand(r)
+}
+
+// v4gen hands out version 4 UUIDs from a buffer of randomness, refilling
+// it from rand in batches to amortise the cost of the underlying read.
+type v4gen struct {
+	mu   sync.Mutex
+	rand io.Reader
+	buf  []byte
+	pos  int
+}
+
+func newV4Gen() *v4gen {
+	return &v4gen{rand: rand.Reader}
+}
+
+func (g *v4gen) setRand(r io.Reader) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if r == nil {
+		r = rand.Reader
+	}
+	g.rand = r
+	g.buf = nil
+	g.pos = 0
+}
+
+func (g *v4gen) generate() (UUID, error) {
+	var u UUID
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.buf == nil || g.pos+16 > len(g.buf) {
+		if g.buf == nil {
+			g.buf = make([]byte, v4BatchUUIDs*16)
+		}
+		if _, err := io.ReadFull(g.rand, g.buf); err != nil {
+			return u, err
+		}
+		g.pos = 0
+	}
+
+	copy(u[:], g.buf[g.pos:g.pos+16])
+	g.pos += 16
+
+	u[6] = (u[6] & 0x0f) | 0x40 // version 4
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return u, nil
+}
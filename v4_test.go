@@ -0,0 +1,62 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func checkV4Format(t *testing.T, u UUID) {
+	t.Helper()
+	if got := u.Version(); got != 4 {
+		t.Errorf("Version() = %d, want 4", got)
+	}
+	if got := u.Variant(); got != VariantRFC4122 {
+		t.Errorf("Variant() = %v, want %v", got, VariantRFC4122)
+	}
+}
+
+func TestNewV4Format(t *testing.T) {
+	u, err := NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkV4Format(t, u)
+}
+
+func TestMustNewV4Format(t *testing.T) {
+	checkV4Format(t, MustNewV4())
+}
+
+func TestNewV4Unique(t *testing.T) {
+	a, err := NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b {
+		t.Errorf("two calls to NewV4 produced the same UUID: %v", a)
+	}
+}
+
+func TestSetRand(t *testing.T) {
+	// More all-zero bytes than a single refill needs, so the test doesn't
+	// depend on the generator's internal batch size.
+	src := bytes.NewReader(make([]byte, 4*v4BatchUUIDs*16))
+	defer SetRand(nil)
+
+	SetRand(src)
+
+	u, err := NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkV4Format(t, u)
+
+	want := UUID{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	if u != want {
+		t.Errorf("NewV4() with deterministic all-zero source = %v, want %v", u, want)
+	}
+}
@@ -0,0 +1,89 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"sync"
+	"time"
+)
+
+// v7Gen is the package-level generator used by NewV7.
+var v7Gen = NewV7Gen()
+
+// NewV7 generates a new version 7 (Unix Epoch time-ordered) UUID using the
+// package-level generator.
+func NewV7() UUID {
+	return v7Gen.Generate()
+}
+
+// V7Gen is a generator of version 7 (Unix Epoch time-ordered) UUIDs, as
+// described in the draft RFC 9562. The leading 48 bits are a millisecond
+// Unix timestamp, which makes UUIDs generated in this way sort
+// lexicographically by creation time and index well as database primary
+// keys.
+//
+// Successive calls to Generate are guaranteed to produce monotonically
+// increasing UUIDs even when multiple calls land in the same millisecond.
+type V7Gen struct {
+	mu       sync.Mutex
+	lastMs   uint64
+	lastRand [10]byte
+}
+
+// NewV7Gen returns a new version 7 UUID generator.
+func NewV7Gen() *V7Gen {
+	return &V7Gen{}
+}
+
+// Generate generates a new version 7 UUID.
+func (g *V7Gen) Generate() UUID {
+	var u UUID
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := uint64(time.Now().UnixMilli())
+	if ms <= g.lastMs {
+		// Clock hasn't advanced (or went backwards): keep monotonicity by
+		// treating the stored rand bits as a counter and incrementing it.
+		ms = g.lastMs
+		incRand(&g.lastRand)
+	} else {
+		if _, err := rand.Read(g.lastRand[:]); err != nil {
+			panic("uuid: failed to read random bytes: " + err.Error())
+		}
+	}
+	g.lastMs = ms
+
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+
+	copy(u[6:16], g.lastRand[:])
+	u[6] = (u[6] & 0x0f) | 0x70 // version 7
+	u[8] = (u[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return u
+}
+
+// incRand treats rand as a big-endian counter and increments it by one,
+// used to preserve ordering when two calls fall within the same
+// millisecond.
+func incRand(rnd *[10]byte) {
+	for i := len(rnd) - 1; i >= 0; i-- {
+		rnd[i]++
+		if rnd[i] != 0 {
+			return
+		}
+	}
+}
+
+// Time returns the timestamp encoded in a version 7 UUID. The result is
+// unspecified if uuid is not a version 7 UUID.
+func (uuid UUID) Time() time.Time {
+	ms := uint64(binary.BigEndian.Uint16(uuid[4:6])) | uint64(binary.BigEndian.Uint32(uuid[0:4]))<<16
+	return time.UnixMilli(int64(ms))
+}
@@ -0,0 +1,66 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestV7Monotonic(t *testing.T) {
+	gen := NewV7Gen()
+
+	const n = 1000
+	var prev UUID
+	for i := 0; i < n; i++ {
+		u := gen.Generate()
+		if i > 0 && u.Compare(prev) <= 0 {
+			t.Fatalf("iteration %d: generated UUID %s is not greater than previous %s", i, u, prev)
+		}
+		prev = u
+	}
+}
+
+func TestV7ClockBackwards(t *testing.T) {
+	gen := NewV7Gen()
+
+	first := gen.Generate()
+
+	// Simulate the system clock jumping backwards: the next Generate call
+	// should still move forward relative to the last emitted UUID instead
+	// of producing a duplicate or a smaller value.
+	gen.mu.Lock()
+	gen.lastMs += 1000
+	gen.mu.Unlock()
+
+	second := gen.Generate()
+	if second.Compare(first) <= 0 {
+		t.Fatalf("UUID generated after simulated clock regression (%s) is not greater than the prior one (%s)", second, first)
+	}
+}
+
+func TestV7Time(t *testing.T) {
+	want := time.UnixMilli(1_700_000_000_123)
+	ms := uint64(want.UnixMilli())
+
+	var u UUID
+	u[0] = byte(ms >> 40)
+	u[1] = byte(ms >> 32)
+	u[2] = byte(ms >> 24)
+	u[3] = byte(ms >> 16)
+	u[4] = byte(ms >> 8)
+	u[5] = byte(ms)
+
+	if got := u.Time(); !got.Equal(want) {
+		t.Errorf("Time() = %v, want %v", got, want)
+	}
+}
+
+func TestV7TimeRoundTrip(t *testing.T) {
+	before := time.Now()
+	u := NewV7()
+	after := time.Now()
+
+	got := u.Time()
+	if got.Before(before.Truncate(time.Millisecond)) || got.After(after) {
+		t.Errorf("Time() = %v, want between %v and %v", got, before, after)
+	}
+}
@@ -0,0 +1,87 @@
+package uuid
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Variant represents the variant encoded in a UUID's layout, as decoded
+// from the top bits of byte 8.
+type Variant int
+
+const (
+	// VariantNCS is reserved for backward compatibility with the obsolete
+	// Apollo Network Computing System UUID format.
+	VariantNCS Variant = iota
+	// VariantRFC4122 is the variant specified by RFC 4122 (and its
+	// successor RFC 9562), used by all of this package's generators.
+	VariantRFC4122
+	// VariantMicrosoft is reserved for backward compatibility with
+	// Microsoft's legacy GUID format.
+	VariantMicrosoft
+	// VariantFuture is reserved for future use.
+	VariantFuture
+)
+
+// Version returns the version number encoded in the high nibble of byte 6,
+// e.g. 4 for a random UUID or 7 for a time-ordered UUID.
+func (uuid UUID) Version() int {
+	return int(uuid[6] >> 4)
+}
+
+// Variant returns the variant encoded in the top bits of byte 8.
+func (uuid UUID) Variant() Variant {
+	switch {
+	case uuid[8]&0x80 == 0x00:
+		return VariantNCS
+	case uuid[8]&0xc0 == 0x80:
+		return VariantRFC4122
+	case uuid[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	default:
+		return VariantFuture
+	}
+}
+
+// IsNil reports whether uuid is the zero-value (Nil) UUID.
+func (uuid UUID) IsNil() bool {
+	return uuid == Nil
+}
+
+// Compare returns an integer comparing uuid and other lexicographically
+// byte by byte. The result is 0 if uuid == other, -1 if uuid < other, and
+// +1 if uuid > other. This ordering matches the lexicographic ordering of
+// their string forms, which for version 7 (time-ordered) UUIDs also
+// matches creation order.
+func (uuid UUID) Compare(other UUID) int {
+	return bytes.Compare(uuid[:], other[:])
+}
+
+// Bytes returns the UUID as a 16-byte slice.
+func (uuid UUID) Bytes() []byte {
+	return uuid[:]
+}
+
+// FromBytes creates a UUID from a 16-byte slice.
+func FromBytes(b []byte) (UUID, error) {
+	var uuid UUID
+	if len(b) != 16 {
+		return uuid, fmt.Errorf("uuid: invalid length %d, expected 16 bytes", len(b))
+	}
+	copy(uuid[:], b)
+	return uuid, nil
+}
+
+// String returns a human-readable name for the variant.
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC4122:
+		return "RFC4122"
+	case VariantMicrosoft:
+		return "Microsoft"
+	default:
+		return "Future"
+	}
+}
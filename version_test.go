@@ -0,0 +1,48 @@
+package uuid
+
+import "testing"
+
+func TestVersionAndVariant(t *testing.T) {
+	u := NewV7()
+	if got := u.Version(); got != 7 {
+		t.Errorf("Version() = %d, want 7", got)
+	}
+	if got := u.Variant(); got != VariantRFC4122 {
+		t.Errorf("Variant() = %v, want %v", got, VariantRFC4122)
+	}
+}
+
+func TestIsNil(t *testing.T) {
+	if !Nil.IsNil() {
+		t.Error("Nil.IsNil() = false, want true")
+	}
+	if NewV7().IsNil() {
+		t.Error("NewV7().IsNil() = true, want false")
+	}
+}
+
+func TestCompareOrdering(t *testing.T) {
+	a := MustParse("00000000-0000-0000-0000-000000000001")
+	b := MustParse("00000000-0000-0000-0000-000000000002")
+
+	if a.Compare(b) >= 0 {
+		t.Errorf("a.Compare(b) = %d, want < 0", a.Compare(b))
+	}
+	if b.Compare(a) <= 0 {
+		t.Errorf("b.Compare(a) = %d, want > 0", b.Compare(a))
+	}
+	if a.Compare(a) != 0 {
+		t.Errorf("a.Compare(a) = %d, want 0", a.Compare(a))
+	}
+}
+
+func TestBytesRoundtrip(t *testing.T) {
+	want := MustParse("15588635-a45e-4867-aadb-dbf0385ade95")
+	got, err := FromBytes(want.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("FromBytes(Bytes()) = %v, want %v", got, want)
+	}
+}